@@ -47,6 +47,38 @@ func StripArgFlags(args []string) []string {
 	return argsNoFlags
 }
 
+// InternalProviders mirrors InternalProvisioners, but for providers that
+// have been compiled into the terraform binary. It is declared here rather
+// than alongside InternalProvisioners so that adding an internal provider
+// does not require touching the (much larger) command registration file.
+var InternalProviders = map[string]plugin.ProviderFunc{}
+
+// ResolveInternalProvider reports whether name refers to a provider that has
+// been compiled into this terraform binary, and if so returns the command
+// string used to invoke it.
+//
+// This is the fallback half of single-binary provider distribution: the
+// plugin/discovery resolver, when it cannot find a provider on disk, should
+// call this and use the returned command in place of a path before giving
+// up. That wiring lives in the plugin/discovery package, which is not part
+// of this tree/snapshot, so ResolveInternalProvider is not yet called from
+// anywhere here — it exists so the discovery-side change is a small, purely
+// additive call to this function rather than a reimplementation of this
+// logic over there.
+func ResolveInternalProvider(name string) (string, bool) {
+	if _, ok := InternalProviders[name]; !ok {
+		return "", false
+	}
+
+	command, err := BuildPluginCommandString("provider", name)
+	if err != nil {
+		log.Printf("[ERROR] Could not build command string for internal provider %s: %s", name, err)
+		return "", false
+	}
+
+	return command, true
+}
+
 func (c *InternalPluginCommand) Run(args []string) int {
 	// strip flags from args, only use subcommands.
 	args = StripArgFlags(args)
@@ -72,6 +104,16 @@ func (c *InternalPluginCommand) Run(args []string) int {
 		plugin.Serve(&plugin.ServeOpts{
 			ProvisionerFunc: pluginFunc,
 		})
+	case "provider":
+		pluginFunc, found := InternalProviders[pluginName]
+		if !found {
+			log.Printf("[ERROR] Could not load provider: %s", pluginName)
+			return 1
+		}
+		log.Printf("[INFO] Starting provider plugin %s", pluginName)
+		plugin.Serve(&plugin.ServeOpts{
+			ProviderFunc: pluginFunc,
+		})
 	default:
 		log.Printf("[ERROR] Invalid plugin type %s", pluginType)
 		return 1
@@ -0,0 +1,145 @@
+package command
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/plugin"
+	"gopkg.in/yaml.v2"
+)
+
+// InternalPluginDescription is the JSON/YAML-serializable description of a
+// single plugin that has been compiled into the terraform binary.
+//
+// SchemaVersion is the go-plugin protocol/handshake version the plugin
+// speaks (plugin.Handshake.ProtocolVersion), not a per-resource schema
+// version: ResourceProvisioner exposes no schema at all, and
+// ResourceProvider's schema is scoped per resource/data source rather than
+// to the provider as a whole, so there is no single per-provider schema
+// version to report. The handshake version is the closest thing to a
+// "declared schema version" that is available for every internal plugin
+// regardless of type.
+type InternalPluginDescription struct {
+	Name          string `json:"name" yaml:"name"`
+	Type          string `json:"type" yaml:"type"`
+	Command       string `json:"command" yaml:"command"`
+	SchemaVersion uint   `json:"schema_version" yaml:"schema_version"`
+}
+
+// DescribeInternalPluginsCommand is a Command implementation that lists every
+// plugin compiled into the terraform binary, in a format meant to be
+// consumed by other programs (CI systems, IDE integrations, wrapper tools)
+// rather than read by a human.
+type DescribeInternalPluginsCommand struct {
+	Meta
+}
+
+func (c *DescribeInternalPluginsCommand) Run(args []string) int {
+	var format string
+
+	cmdFlags := flag.NewFlagSet("internal-plugin-describe", flag.ContinueOnError)
+	cmdFlags.StringVar(&format, "format", "json", "output format: json or yaml")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	descriptions := c.describeInternalPlugins()
+
+	var (
+		out []byte
+		err error
+	)
+	switch format {
+	case "json":
+		out, err = json.MarshalIndent(descriptions, "", "  ")
+	case "yaml":
+		out, err = yaml.Marshal(descriptions)
+	default:
+		c.Ui.Error(fmt.Sprintf("Invalid -format value: %s", format))
+		return 1
+	}
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal internal plugin descriptions: %s", err)
+		return 1
+	}
+
+	c.Ui.Output(string(out))
+	return 0
+}
+
+// describeInternalPlugins builds the list of descriptions from
+// InternalProvisioners and InternalProviders, sorted by type and then name
+// so that output is stable across runs.
+func (c *DescribeInternalPluginsCommand) describeInternalPlugins() []InternalPluginDescription {
+	descriptions := make([]InternalPluginDescription, 0, len(InternalProvisioners)+len(InternalProviders))
+
+	for name := range InternalProvisioners {
+		command, err := BuildPluginCommandString("provisioner", name)
+		if err != nil {
+			log.Printf("[ERROR] Could not build command string for provisioner %s: %s", name, err)
+			continue
+		}
+
+		descriptions = append(descriptions, InternalPluginDescription{
+			Name:          name,
+			Type:          "provisioner",
+			Command:       command,
+			SchemaVersion: plugin.Handshake.ProtocolVersion,
+		})
+	}
+
+	for name := range InternalProviders {
+		command, err := BuildPluginCommandString("provider", name)
+		if err != nil {
+			log.Printf("[ERROR] Could not build command string for provider %s: %s", name, err)
+			continue
+		}
+
+		descriptions = append(descriptions, InternalPluginDescription{
+			Name:          name,
+			Type:          "provider",
+			Command:       command,
+			SchemaVersion: plugin.Handshake.ProtocolVersion,
+		})
+	}
+
+	sort.Slice(descriptions, func(i, j int) bool {
+		if descriptions[i].Type != descriptions[j].Type {
+			return descriptions[i].Type < descriptions[j].Type
+		}
+		return descriptions[i].Name < descriptions[j].Name
+	})
+
+	return descriptions
+}
+
+func (c *DescribeInternalPluginsCommand) Help() string {
+	helpText := `
+Usage: terraform internal-plugin-describe [-format=json|yaml]
+
+  Lists every plugin that has been compiled into this terraform binary,
+  as machine-readable JSON or YAML.
+
+  Each entry includes the plugin's name, type (provider or provisioner),
+  the exact command used to invoke it as an internal plugin, and the
+  plugin protocol version it speaks.
+
+  NOTE: this is an internal command and you should not call it yourself.
+
+Options:
+
+  -format=json|yaml   Output format. Defaults to json.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *DescribeInternalPluginsCommand) Synopsis() string {
+	return "describe internal plugin command"
+}
+
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestDescribeInternalPluginsCommand(t *testing.T) {
+	defer func(provisioners map[string]plugin.ProvisionerFunc, providers map[string]plugin.ProviderFunc) {
+		InternalProvisioners = provisioners
+		InternalProviders = providers
+	}(InternalProvisioners, InternalProviders)
+
+	InternalProvisioners = map[string]plugin.ProvisionerFunc{
+		"b-provisioner": func() terraform.ResourceProvisioner { return nil },
+		"a-provisioner": func() terraform.ResourceProvisioner { return nil },
+	}
+	InternalProviders = map[string]plugin.ProviderFunc{
+		"z-provider": func() terraform.ResourceProvider { return nil },
+	}
+
+	t.Run("json", func(t *testing.T) {
+		ui := new(cli.MockUi)
+		c := &DescribeInternalPluginsCommand{Meta: Meta{Ui: ui}}
+
+		if code := c.Run([]string{"-format=json"}); code != 0 {
+			t.Fatalf("bad exit code: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+
+		out := ui.OutputWriter.String()
+		wantOrder := []string{`"z-provider"`, `"a-provisioner"`, `"b-provisioner"`}
+		lastIndex := -1
+		for _, name := range wantOrder {
+			idx := strings.Index(out, name)
+			if idx == -1 {
+				t.Fatalf("expected output to contain %s, got:\n%s", name, out)
+			}
+			if idx < lastIndex {
+				t.Fatalf("expected %s to sort after previous entry, got:\n%s", name, out)
+			}
+			lastIndex = idx
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		ui := new(cli.MockUi)
+		c := &DescribeInternalPluginsCommand{Meta: Meta{Ui: ui}}
+
+		if code := c.Run([]string{"-format=yaml"}); code != 0 {
+			t.Fatalf("bad exit code: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+
+		out := ui.OutputWriter.String()
+		for _, name := range []string{"z-provider", "a-provisioner", "b-provisioner"} {
+			if !strings.Contains(out, name) {
+				t.Fatalf("expected output to contain %s, got:\n%s", name, out)
+			}
+		}
+	})
+
+	t.Run("schema version", func(t *testing.T) {
+		ui := new(cli.MockUi)
+		c := &DescribeInternalPluginsCommand{Meta: Meta{Ui: ui}}
+
+		if code := c.Run([]string{"-format=json"}); code != 0 {
+			t.Fatalf("bad exit code: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+
+		out := ui.OutputWriter.String()
+		want := fmt.Sprintf(`"schema_version": %d`, plugin.Handshake.ProtocolVersion)
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected output to contain %s, got:\n%s", want, out)
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		ui := new(cli.MockUi)
+		c := &DescribeInternalPluginsCommand{Meta: Meta{Ui: ui}}
+
+		if code := c.Run([]string{"-format=xml"}); code == 0 {
+			t.Fatalf("expected non-zero exit code for an invalid -format value")
+		}
+		if !strings.Contains(ui.ErrorWriter.String(), "Invalid -format value") {
+			t.Fatalf("expected the invalid -format value to be reported via the UI, got:\n%s", ui.ErrorWriter.String())
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResolveInternalProvider(t *testing.T) {
+	defer func(providers map[string]plugin.ProviderFunc) {
+		InternalProviders = providers
+	}(InternalProviders)
+
+	InternalProviders = map[string]plugin.ProviderFunc{
+		"test": func() terraform.ResourceProvider { return nil },
+	}
+
+	command, ok := ResolveInternalProvider("test")
+	if !ok {
+		t.Fatalf("expected ResolveInternalProvider to find the registered internal provider")
+	}
+	if command == "" {
+		t.Fatalf("expected a non-empty command string")
+	}
+
+	if _, ok := ResolveInternalProvider("does-not-exist"); ok {
+		t.Fatalf("expected ResolveInternalProvider to report false for an unregistered provider")
+	}
+}